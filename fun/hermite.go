@@ -0,0 +1,151 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+	"github.com/cpmech/gosl/io"
+	"github.com/cpmech/gosl/plt"
+)
+
+// Sspair defines a function f(x) that also returns its first derivative df/dx, to be used
+// with HermiteInterp
+type Sspair func(x float64) (f, df float64, err error)
+
+// HermiteInterp implements the Hermite interpolator associated with a grid X: the unique
+// polynomial of degree ≤ 2N+1 that matches both f and f' at the N+1 grid nodes.
+//
+//   The interpolant is expressed as:
+//
+//                     N
+//         X          ————                                 X
+//        I {f}(x) =  \     f(X[i]) ⋅ h (x)  +  f'(X[i]) ⋅ ĥ (x)
+//         N          /                i                    i
+//                    ————
+//                    i = 0
+//
+//   where the generalised Lagrange basis functions are:
+//
+//          h (x) = (1 - 2 (x - X[i]) ⋅ ℓ'_i(X[i])) ⋅ ℓ_i(x)²
+//           i
+//
+//          ĥ (x) = (x - X[i]) ⋅ ℓ_i(x)²
+//           i
+//
+//   with ℓ_i the i-th Lagrange cardinal polynomial associated with grid X, and
+//   ℓ'_i(X[i]) = Σ_{j≠i} 1/(X[i]-X[j]).
+//
+//   NOTE: HermiteInterp shares its grid generation and cardinal basis ℓ_i with LagrangeInterp;
+//   see that type for the supported grid kinds.
+type HermiteInterp struct {
+	N int       // degree parameter: N = len(X)-1; resulting polynomial has degree ≤ 2N+1
+	X []float64 // grid points: len(X) = N+1; generated in [-1, 1]
+	W []float64 // barycentric weights associated with X; len(W) = N+1
+	D []float64 // D[i] = ℓ'_i(X[i]) = Σ_{j≠i} 1/(X[i]-X[j]); len(D) = N+1
+}
+
+// NewHermiteInterp allocates a new HermiteInterp
+//   N        -- degree parameter; the resulting polynomial has degree ≤ 2N+1
+//   gridType -- type of grid; e.g. uniform, Chebyshev-Gauss, Chebyshev-Gauss-Lobatto
+//   NOTE: the grid will be generated in [-1, 1]
+func NewHermiteInterp(N int, gridType io.Enum) (o *HermiteInterp, err error) {
+	lip, err := NewLagrangeInterp(N, gridType)
+	if err != nil {
+		return nil, err
+	}
+	o = new(HermiteInterp)
+	o.N = lip.N
+	o.X = lip.X
+	o.W = lip.W
+	o.D = make([]float64, o.N+1)
+	for i := 0; i < o.N+1; i++ {
+		for j := 0; j < o.N+1; j++ {
+			if j != i {
+				o.D[i] += 1.0 / (o.X[i] - o.X[j])
+			}
+		}
+	}
+	return
+}
+
+// L computes the i-th Lagrange cardinal polynomial ℓ_i(x) associated with grid X, using the
+// barycentric form (see LagrangeInterp.L)
+func (o *HermiteInterp) L(i int, x float64) (lix float64) {
+	var num, den float64
+	for j := 0; j < o.N+1; j++ {
+		δ := x - o.X[j]
+		if math.Abs(δ) < tolBaryX {
+			if j == i {
+				return 1
+			}
+			return 0
+		}
+		term := o.W[j] / δ
+		den += term
+		if j == i {
+			num = term
+		}
+	}
+	return num / den
+}
+
+// H computes the i-th Hermite basis function h_i(x) associated with the function values
+func (o *HermiteInterp) H(i int, x float64) float64 {
+	lix := o.L(i, x)
+	return (1 - 2*(x-o.X[i])*o.D[i]) * lix * lix
+}
+
+// Hhat computes the i-th Hermite basis function ĥ_i(x) associated with the derivative values
+func (o *HermiteInterp) Hhat(i int, x float64) float64 {
+	lix := o.L(i, x)
+	return (x - o.X[i]) * lix * lix
+}
+
+// I computes the interpolation I^X_N{f}(x) @ x
+func (o *HermiteInterp) I(x float64, f Sspair) (ix float64, err error) {
+	for i := 0; i < o.N+1; i++ {
+		fxi, dfxi, e := f(o.X[i])
+		if e != nil {
+			return 0, e
+		}
+		ix += fxi*o.H(i, x) + dfxi*o.Hhat(i, x)
+	}
+	return
+}
+
+// EstimateMaxErr estimates the maximum error using 10000 stations along [-1,1]
+// This function also returns the location (xloc) of the estimated max error
+func (o *HermiteInterp) EstimateMaxErr(f Sspair) (maxerr, xloc float64) {
+	nsta := 10000 // generate several points along [-1,1]
+	xloc = -1
+	for i := 0; i < nsta; i++ {
+		x := -1.0 + 2.0*float64(i)/float64(nsta-1)
+		fx, _, err := f(x)
+		if err != nil {
+			chk.Panic("f(x) failed:%v\n", err)
+		}
+		ix, err := o.I(x, f)
+		if err != nil {
+			chk.Panic("I(x) failed:%v\n", err)
+		}
+		e := math.Abs(fx - ix)
+		if e > maxerr {
+			maxerr = e
+			xloc = x
+		}
+	}
+	return
+}
+
+// DrawPoints draw points
+func (o *HermiteInterp) DrawPoints(args *plt.A) {
+	if args == nil {
+		args = &plt.A{C: "k", Ls: "none", M: "o", Void: true, NoClip: true}
+	}
+	Y := make([]float64, len(o.X))
+	plt.Plot(o.X, Y, args)
+}
@@ -0,0 +1,56 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/io"
+)
+
+func TestHermiteInterpMatchesValueAndDerivativeAtNodes(t *testing.T) {
+	N := 6
+	h := 1e-6
+	f := func(x float64) (float64, float64, error) { return math.Sin(x), math.Cos(x), nil }
+	for _, kind := range []io.Enum{UniformGridKind, ChebyshevGaussKind, ChebyshevGaussLobattoKind} {
+		o, err := NewHermiteInterp(N, kind)
+		if err != nil {
+			t.Fatalf("kind=%v: %v", kind, err)
+		}
+		for i, xi := range o.X {
+			ix, err := o.I(xi, f)
+			if err != nil {
+				t.Fatalf("kind=%v: I failed: %v", kind, err)
+			}
+			fx, dfx, _ := f(xi)
+			if math.Abs(ix-fx) > 1e-9 {
+				t.Errorf("kind=%v node %d: I(X[%d])=%v != f(X[%d])=%v", kind, i, i, ix, i, fx)
+			}
+			// central difference of the interpolant at the node should match f'(X[i])
+			ixp, err := o.I(xi+h, f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ixm, err := o.I(xi-h, f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dix := (ixp - ixm) / (2 * h)
+			if math.Abs(dix-dfx) > 1e-5 {
+				t.Errorf("kind=%v node %d: I'(X[%d])=%v != f'(X[%d])=%v", kind, i, i, dix, i, dfx)
+			}
+		}
+	}
+}
+
+func TestHermiteInterpDegenerateN(t *testing.T) {
+	// the Chebyshev-Gauss-Lobatto grid is undefined for N=0; HermiteInterp forwards the
+	// grid construction to NewLagrangeInterp and must propagate that error, not build a
+	// HermiteInterp with a NaN node
+	if _, err := NewHermiteInterp(0, ChebyshevGaussLobattoKind); err == nil {
+		t.Errorf("expected an error for N=0 with ChebyshevGaussLobattoKind, got nil")
+	}
+}
@@ -18,6 +18,12 @@ var (
 
 	// UniformGridKind defines the uniform 1D grid kind
 	UniformGridKind = io.NewEnum("Uniform", "fun.uniform", "U", "Uniform 1D grid")
+
+	// ChebyshevGaussKind defines the Chebyshev-Gauss 1D grid kind
+	ChebyshevGaussKind = io.NewEnum("ChebyshevGauss", "fun.chebyshevGauss", "CG", "Chebyshev-Gauss 1D grid")
+
+	// ChebyshevGaussLobattoKind defines the Chebyshev-Gauss-Lobatto 1D grid kind
+	ChebyshevGaussLobattoKind = io.NewEnum("ChebyshevGaussLobatto", "fun.chebyshevGaussLobatto", "CGL", "Chebyshev-Gauss-Lobatto 1D grid")
 )
 
 // LagrangeInterp implements Lagrange interpolators associated with a grid X
@@ -44,11 +50,13 @@ var (
 type LagrangeInterp struct {
 	N int       // degree: N = len(X)-1
 	X []float64 // grid points: len(X) = P+1; generated in [-1, 1]
+	W []float64 // barycentric weights: len(W) = N+1
+	U []float64 // function values cached by CalcU: U[i] = f(X[i]); len(U) = N+1
 }
 
 // NewLagrangeInterp allocates a new LagrangeInterp
 //   N        -- degree
-//   gridType -- type of grid; e.g. uniform
+//   gridType -- type of grid; e.g. uniform, Chebyshev-Gauss, Chebyshev-Gauss-Lobatto
 //   NOTE: the grid will be generated in [-1, 1]
 func NewLagrangeInterp(N int, gridType io.Enum) (o *LagrangeInterp, err error) {
 	if N < 0 {
@@ -59,13 +67,50 @@ func NewLagrangeInterp(N int, gridType io.Enum) (o *LagrangeInterp, err error) {
 	switch gridType {
 	case UniformGridKind:
 		o.X = utl.LinSpace(-1, 1, N+1)
+	case ChebyshevGaussKind:
+		o.X = make([]float64, N+1)
+		for i := 0; i < N+1; i++ {
+			o.X[i] = -math.Cos(float64(2*i+1) * math.Pi / float64(2*N+2))
+		}
+	case ChebyshevGaussLobattoKind:
+		if N == 0 {
+			return nil, chk.Err("N must be at least equal to 1 for the Chebyshev-Gauss-Lobatto grid. N=%d is invalid\n", N)
+		}
+		o.X = make([]float64, N+1)
+		for i := 0; i < N+1; i++ {
+			o.X[i] = -math.Cos(float64(i) * math.Pi / float64(N))
+		}
 	default:
 		return nil, chk.Err("cannot create grid type %q\n", gridType)
 	}
+	o.W = make([]float64, N+1)
+	switch gridType {
+	case ChebyshevGaussLobattoKind:
+		for j := 0; j < N+1; j++ {
+			δj := 1.0
+			if j == 0 || j == N {
+				δj = 0.5
+			}
+			o.W[j] = math.Pow(-1, float64(j)) * δj
+		}
+	case ChebyshevGaussKind:
+		for j := 0; j < N+1; j++ {
+			o.W[j] = math.Pow(-1, float64(j)) * math.Sin(float64(2*j+1)*math.Pi/float64(2*N+2))
+		}
+	default:
+		for j := 0; j < N+1; j++ {
+			o.W[j] = 1
+			for k := 0; k < N+1; k++ {
+				if k != j {
+					o.W[j] /= o.X[j] - o.X[k]
+				}
+			}
+		}
+	}
 	return
 }
 
-// W computes the generating (nodal) polynomial associated with grid X. The nodal polynomial is the
+// Nodal computes the generating (nodal) polynomial associated with grid X. The nodal polynomial is the
 // unique polynomial of degree N+1 and leading coefficient whose zeros are the N+1 nodes of X.
 //
 //                 N
@@ -74,7 +119,7 @@ func NewLagrangeInterp(N int, gridType io.Enum) (o *LagrangeInterp, err error) {
 //        N+1     ┃  ┃
 //               i = 0
 //
-func (o *LagrangeInterp) W(x float64) (w float64) {
+func (o *LagrangeInterp) Nodal(x float64) (w float64) {
 	w = 1
 	for i := 0; i < o.N+1; i++ {
 		w *= x - o.X[i]
@@ -82,14 +127,21 @@ func (o *LagrangeInterp) W(x float64) (w float64) {
 	return
 }
 
-// L computes the i-th Lagrange cardinal polynomial ℓ^X_i(x) associated with grid X
+// tolBaryX is the tolerance used to detect when x coincides with a grid node in the
+// barycentric formulas
+const tolBaryX = 1e-13
+
+// L computes the i-th Lagrange cardinal polynomial ℓ^X_i(x) associated with grid X, using the
+// second (true) barycentric form of Berrut & Trefethen (2004):
 //
-//                 N
-//         X      ━━━━    x  -  X[j]
-//        ℓ (x) = ┃  ┃  —————————————           0 ≤ i ≤ N
-//         i      ┃  ┃   X[i] - X[j]
-//               j = 0
-//               j ≠ i
+//                  W[i] / (x - X[i])
+//        ℓ (x) = ———————————————————           0 ≤ i ≤ N
+//         i        N
+//                 ————
+//                 \    W[j] / (x - X[j])
+//                 /
+//                 ————
+//                 j = 0
 //
 //   Input:
 //      i -- index of X[i] point
@@ -97,33 +149,56 @@ func (o *LagrangeInterp) W(x float64) (w float64) {
 //   Output:
 //      lix -- ℓ^X_i(x)
 func (o *LagrangeInterp) L(i int, x float64) (lix float64) {
-	lix = 1
+	var num, den float64
 	for j := 0; j < o.N+1; j++ {
-		if i != j {
-			lix *= (x - o.X[j]) / (o.X[i] - o.X[j])
+		δ := x - o.X[j]
+		if math.Abs(δ) < tolBaryX {
+			if j == i {
+				return 1
+			}
+			return 0
+		}
+		term := o.W[j] / δ
+		den += term
+		if j == i {
+			num = term
 		}
 	}
-	return
+	return num / den
 }
 
-// I computes the interpolation I^X_N{f}(x) @ x
+// I computes the interpolation I^X_N{f}(x) @ x using the barycentric formula
 //
-//                     N
-//         X          ————             X
-//        I {f}(x) =  \     f(x[i]) ⋅ ℓ (x)
-//         N          /                i
-//                    ————
-//                    i = 0
+//                N
+//               ————   W[i]
+//               \     ——————— ⋅ f(X[i])
+//               /     x - X[i]
+//               ————
+//   X          i = 0
+//  I {f}(x) = —————————————————————————
+//   N              N
+//                  ————   W[i]
+//                  \     ———————
+//                  /     x - X[i]
+//                  ————
+//                 i = 0
 //
 func (o *LagrangeInterp) I(x float64, f Ss) (ix float64, err error) {
+	var num, den float64
 	for i := 0; i < o.N+1; i++ {
+		δ := x - o.X[i]
+		if math.Abs(δ) < tolBaryX {
+			return f(o.X[i])
+		}
 		fxi, e := f(o.X[i])
 		if e != nil {
 			return 0, e
 		}
-		ix += fxi * o.L(i, x)
+		term := o.W[i] / δ
+		num += term * fxi
+		den += term
 	}
-	return
+	return num / den, nil
 }
 
 // EstimateLebesgue estimates the Lebesgue constant by using 10000 stations along [-1,1]
@@ -174,3 +249,53 @@ func (o *LagrangeInterp) DrawPoints(args *plt.A) {
 	Y := make([]float64, len(o.X))
 	plt.Plot(o.X, Y, args)
 }
+
+// CalcU calculates and caches U[i] = f(X[i]), so that Eval and EvalMany can reuse the function
+// values instead of re-calling f on every evaluation
+func (o *LagrangeInterp) CalcU(f Ss) (U []float64, err error) {
+	o.U = make([]float64, o.N+1)
+	for i := 0; i < o.N+1; i++ {
+		fxi, e := f(o.X[i])
+		if e != nil {
+			return nil, e
+		}
+		o.U[i] = fxi
+	}
+	return o.U, nil
+}
+
+// Eval evaluates the interpolation at x using the barycentric formula and the function values
+// cached by CalcU. CalcU must be called beforehand
+func (o *LagrangeInterp) Eval(x float64) (ix float64) {
+	var num, den float64
+	for i := 0; i < o.N+1; i++ {
+		δ := x - o.X[i]
+		if math.Abs(δ) < tolBaryX {
+			return o.U[i]
+		}
+		term := o.W[i] / δ
+		num += term * o.U[i]
+		den += term
+	}
+	return num / den
+}
+
+// EvalMany evaluates the interpolation at each point in xip, reusing the function values
+// cached by CalcU. CalcU must be called beforehand
+func (o *LagrangeInterp) EvalMany(xip []float64) (fip []float64) {
+	fip = make([]float64, len(xip))
+	for k, x := range xip {
+		fip[k] = o.Eval(x)
+	}
+	return
+}
+
+// ResampleUniform evaluates the interpolation, using the function values cached by CalcU, on
+// m evenly spaced points over [-1, 1]. This is useful, e.g., to map values sampled on a
+// Chebyshev grid onto a uniform grid for plotting or for downstream FFT / finite-difference
+// use. CalcU must be called beforehand
+func (o *LagrangeInterp) ResampleUniform(m int) (xip, fip []float64) {
+	xip = utl.LinSpace(-1, 1, m)
+	fip = o.EvalMany(xip)
+	return
+}
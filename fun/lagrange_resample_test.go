@@ -0,0 +1,66 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLagrangeInterpCalcUEvalMatchesI(t *testing.T) {
+	N := 12
+	o, err := NewLagrangeInterp(N, ChebyshevGaussKind)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := func(x float64) (float64, error) { return math.Exp(x), nil }
+	if _, err = o.CalcU(f); err != nil {
+		t.Fatal(err)
+	}
+	for _, x := range []float64{-0.95, -0.4, 0.1, 0.77, 0.999999} {
+		want, err := o.I(x, f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := o.Eval(x)
+		if math.Abs(got-want) > 1e-9 {
+			t.Errorf("Eval(%v)=%v != I(%v)=%v", x, got, x, want)
+		}
+	}
+	xip := []float64{-0.8, -0.1, 0.3, 0.85}
+	fip := o.EvalMany(xip)
+	for k, x := range xip {
+		want, _ := o.I(x, f)
+		if math.Abs(fip[k]-want) > 1e-9 {
+			t.Errorf("EvalMany[%d]=%v != I(%v)=%v", k, fip[k], x, want)
+		}
+	}
+}
+
+func TestLagrangeInterpResampleUniform(t *testing.T) {
+	N := 16
+	o, err := NewLagrangeInterp(N, ChebyshevGaussLobattoKind)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := func(x float64) (float64, error) { return math.Cos(2 * x), nil }
+	if _, err = o.CalcU(f); err != nil {
+		t.Fatal(err)
+	}
+	m := 21
+	xip, fip := o.ResampleUniform(m)
+	if len(xip) != m || len(fip) != m {
+		t.Fatalf("expected %d points, got len(xip)=%d len(fip)=%d", m, len(xip), len(fip))
+	}
+	if math.Abs(xip[0]-(-1)) > 1e-12 || math.Abs(xip[m-1]-1) > 1e-12 {
+		t.Errorf("expected xip to span [-1,1], got xip[0]=%v xip[m-1]=%v", xip[0], xip[m-1])
+	}
+	for i, x := range xip {
+		want, _ := f(x)
+		if math.Abs(fip[i]-want) > 1e-6 {
+			t.Errorf("ResampleUniform mismatch at x=%v: got %v want %v", x, fip[i], want)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cpmech/gosl/io"
+)
+
+func TestLagrangeInterpAtNodes(t *testing.T) {
+	N := 8
+	f := func(x float64) (float64, error) { return math.Sin(3 * x), nil }
+	for _, kind := range []io.Enum{UniformGridKind, ChebyshevGaussKind, ChebyshevGaussLobattoKind} {
+		o, err := NewLagrangeInterp(N, kind)
+		if err != nil {
+			t.Fatalf("kind=%v: %v", kind, err)
+		}
+		for i, xi := range o.X {
+			ix, err := o.I(xi, f)
+			if err != nil {
+				t.Fatalf("kind=%v: I failed: %v", kind, err)
+			}
+			fx, _ := f(xi)
+			if math.Abs(ix-fx) > 1e-9 {
+				t.Errorf("kind=%v node %d: I(X[%d])=%v != f(X[%d])=%v", kind, i, i, ix, i, fx)
+			}
+		}
+	}
+}
+
+func TestLagrangeInterpPartitionOfUnity(t *testing.T) {
+	N := 10
+	stations := []float64{-0.95, -0.3, 0.0, 0.42, 0.97}
+	for _, kind := range []io.Enum{UniformGridKind, ChebyshevGaussKind, ChebyshevGaussLobattoKind} {
+		o, err := NewLagrangeInterp(N, kind)
+		if err != nil {
+			t.Fatalf("kind=%v: %v", kind, err)
+		}
+		for _, x := range stations {
+			sum := 0.0
+			for i := 0; i < N+1; i++ {
+				sum += o.L(i, x)
+			}
+			if math.Abs(sum-1.0) > 1e-9 {
+				t.Errorf("kind=%v: partition of unity fails at x=%v: sum=%v", kind, x, sum)
+			}
+		}
+	}
+}
+
+func TestLagrangeInterpDegenerateN(t *testing.T) {
+	// N=0 is valid for Uniform and Chebyshev-Gauss (a single node), but the
+	// Chebyshev-Gauss-Lobatto formula divides by N, so N=0 must be rejected
+	for _, kind := range []io.Enum{UniformGridKind, ChebyshevGaussKind} {
+		o, err := NewLagrangeInterp(0, kind)
+		if err != nil {
+			t.Fatalf("kind=%v: unexpected error for N=0: %v", kind, err)
+		}
+		if len(o.X) != 1 || math.IsNaN(o.X[0]) {
+			t.Errorf("kind=%v: expected a single valid node for N=0, got X=%v", kind, o.X)
+		}
+	}
+	if _, err := NewLagrangeInterp(0, ChebyshevGaussLobattoKind); err == nil {
+		t.Errorf("expected an error for N=0 with ChebyshevGaussLobattoKind, got nil")
+	}
+}
@@ -0,0 +1,335 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+
+	"github.com/cpmech/gosl/chk"
+)
+
+// SsND defines a function f(x) for x in d-dimensions, to be interpolated
+type SsND func(x []float64) (f float64, err error)
+
+// LagrangeInterpNDTensor implements a tensor-product multi-dimensional Lagrange interpolator,
+// built from d independent 1-D LagrangeInterp grids (one per axis). The interpolant is
+//
+//                      ————             ————             d
+//          X          \                \       f(X ,...,X   ) ⋅  ┃  ┃ ℓ^{X_k}  (x )
+//         I {f}(x) =  /     ⋯       /          1,i1    d,id      ┃  ┃    i_k     k
+//          N          ————            ————                      k = 1
+//                     i1 = 0,N1      id = 0,Nd
+//
+//   NOTE: the interpolant is continuous across patch boundaries whenever the per-axis node
+//   sets agree on the shared face; this is the main reason to prefer the tensor-product form
+//   over the total-degree one when stitching several patches together.
+type LagrangeInterpNDTensor struct {
+	D    int               // number of dimensions
+	Axes []*LagrangeInterp // one LagrangeInterp per axis; len(Axes) = D
+}
+
+// NewLagrangeInterpNDTensor allocates a new LagrangeInterpNDTensor
+//   axes -- one LagrangeInterp per dimension, already built with NewLagrangeInterp
+func NewLagrangeInterpNDTensor(axes []*LagrangeInterp) (o *LagrangeInterpNDTensor, err error) {
+	if len(axes) < 1 {
+		return nil, chk.Err("at least one axis is required. len(axes)=%d is invalid\n", len(axes))
+	}
+	for k, axis := range axes {
+		if axis == nil {
+			return nil, chk.Err("axis %d is nil\n", k)
+		}
+	}
+	o = new(LagrangeInterpNDTensor)
+	o.D = len(axes)
+	o.Axes = axes
+	return
+}
+
+// I computes the tensor-product interpolation I{f}(x) @ x
+//   Input:
+//      x -- point in ℝ^D where to evaluate the interpolation
+//      f -- function f(X) evaluated at the tensor grid nodes X
+func (o *LagrangeInterpNDTensor) I(x []float64, f SsND) (ix float64, err error) {
+	idx := make([]int, o.D)
+	return o.sumTensor(0, idx, x, f)
+}
+
+// sumTensor recursively walks the D-dimensional grid of nodes, accumulating
+// f(X_idx) ⋅ Πk ℓ^{X_k}_{idx[k]}(x[k])
+func (o *LagrangeInterpNDTensor) sumTensor(k int, idx []int, x []float64, f SsND) (sum float64, err error) {
+	if k == o.D {
+		node := make([]float64, o.D)
+		weight := 1.0
+		for d := 0; d < o.D; d++ {
+			node[d] = o.Axes[d].X[idx[d]]
+			weight *= o.Axes[d].L(idx[d], x[d])
+		}
+		fx, e := f(node)
+		if e != nil {
+			return 0, e
+		}
+		return fx * weight, nil
+	}
+	for i := 0; i < o.Axes[k].N+1; i++ {
+		idx[k] = i
+		s, e := o.sumTensor(k+1, idx, x, f)
+		if e != nil {
+			return 0, e
+		}
+		sum += s
+	}
+	return
+}
+
+// L computes the tensor-product cardinal basis function associated with the multi-index i,
+// evaluated at x: Πk ℓ^{X_k}_{i[k]}(x[k])
+//   Input:
+//      i -- multi-index of the node; len(i) = D
+//      x -- point in ℝ^D where to evaluate the basis function
+func (o *LagrangeInterpNDTensor) L(i []int, x []float64) (lix float64) {
+	lix = 1
+	for k := 0; k < o.D; k++ {
+		lix *= o.Axes[k].L(i[k], x[k])
+	}
+	return
+}
+
+// EstimateMaxErr estimates the maximum error by sampling nsta^D points uniformly over the
+// tensor-product of each axis' [-1,1] range. It also returns the location (xloc) of the
+// estimated max error
+func (o *LagrangeInterpNDTensor) EstimateMaxErr(nsta int, f SsND) (maxerr float64, xloc []float64) {
+	idx := make([]int, o.D)
+	xloc = make([]float64, o.D)
+	o.walkGrid(0, nsta, idx, make([]float64, o.D), f, &maxerr, xloc)
+	return
+}
+
+// walkGrid recursively walks a uniform nsta^D sampling grid, updating maxerr/xloc in place
+func (o *LagrangeInterpNDTensor) walkGrid(k, nsta int, idx []int, x []float64, f SsND, maxerr *float64, xloc []float64) {
+	if k == o.D {
+		fx, err := f(x)
+		if err != nil {
+			chk.Panic("f(x) failed:%v\n", err)
+		}
+		ix, err := o.I(x, f)
+		if err != nil {
+			chk.Panic("I(x) failed:%v\n", err)
+		}
+		e := math.Abs(fx - ix)
+		if e > *maxerr {
+			*maxerr = e
+			copy(xloc, x)
+		}
+		return
+	}
+	for i := 0; i < nsta; i++ {
+		x[k] = -1.0 + 2.0*float64(i)/float64(nsta-1)
+		o.walkGrid(k+1, nsta, idx, x, f, maxerr, xloc)
+	}
+}
+
+// LagrangeInterpNDTotalDegree implements a maximum-total-degree multi-dimensional Lagrange
+// interpolator: the unique polynomial of total degree ≤ N, in D variables, that is the
+// cardinal interpolant of a given node set. Multi-indices α (monomial exponents) with
+// |α|_1 ≤ N are enumerated in graded lexicographic order (following the mono_between_enum /
+// mono_upto_enum / mono_between_next_grlex scheme of the LAGRANGE_ND library), and each
+// cardinal basis function is the unique combination of monomials {x^α} that is 1 on its
+// associated node and 0 on every other node, found via a Vandermonde solve.
+type LagrangeInterpNDTotalDegree struct {
+	D      int         // number of dimensions
+	N      int         // maximum total degree
+	Nodes  [][]float64 // interpolation nodes; len(Nodes) = M = number of monomials with |α|≤N
+	Alphas [][]int     // monomial exponents, graded lexicographic order; len(Alphas) = M
+	Coefs  [][]float64 // Coefs[k] holds the monomial coefficients of the k-th cardinal basis function
+}
+
+// NewLagrangeInterpNDTotalDegree allocates a new LagrangeInterpNDTotalDegree
+//   d     -- number of dimensions
+//   N     -- maximum total degree
+//   nodes -- M interpolation nodes in ℝ^d, where M = C(d+N,d); nodes[i] has length d
+func NewLagrangeInterpNDTotalDegree(d, N int, nodes [][]float64) (o *LagrangeInterpNDTotalDegree, err error) {
+	if d < 1 {
+		return nil, chk.Err("d must be at least equal to 1. d=%d is invalid\n", d)
+	}
+	if N < 0 {
+		return nil, chk.Err("N must be at least equal to 0. N=%d is invalid\n", N)
+	}
+	alphas := monoUpToEnum(d, N)
+	m := len(alphas)
+	if len(nodes) != m {
+		return nil, chk.Err("number of nodes must equal C(d+N,d)=%d for d=%d and N=%d. len(nodes)=%d is invalid\n", m, d, N, len(nodes))
+	}
+	for i, node := range nodes {
+		if len(node) != d {
+			return nil, chk.Err("node %d must have length d=%d. len(nodes[%d])=%d is invalid\n", i, d, i, len(node))
+		}
+	}
+	o = new(LagrangeInterpNDTotalDegree)
+	o.D = d
+	o.N = N
+	o.Nodes = nodes
+	o.Alphas = alphas
+
+	// Vandermonde matrix: V[i][j] = node[i]^alphas[j]
+	V := make([][]float64, m)
+	for i := 0; i < m; i++ {
+		V[i] = make([]float64, m)
+		for j := 0; j < m; j++ {
+			V[i][j] = monomial(nodes[i], alphas[j])
+		}
+	}
+
+	// solve V ⋅ Coefs^T = I, i.e. invert V; column k of the inverse gives the coefficients
+	// (in the monomial basis) of the k-th cardinal basis function
+	Vinv, err := matInv(V)
+	if err != nil {
+		return nil, chk.Err("cannot build cardinal basis functions: %v\n", err)
+	}
+	o.Coefs = make([][]float64, m)
+	for k := 0; k < m; k++ {
+		o.Coefs[k] = make([]float64, m)
+		for j := 0; j < m; j++ {
+			o.Coefs[k][j] = Vinv[j][k]
+		}
+	}
+	return
+}
+
+// I computes the total-degree interpolation I{f}(x) @ x
+func (o *LagrangeInterpNDTotalDegree) I(x []float64, f SsND) (ix float64, err error) {
+	for k := range o.Nodes {
+		fxk, e := f(o.Nodes[k])
+		if e != nil {
+			return 0, e
+		}
+		ix += fxk * o.L(k, x)
+	}
+	return
+}
+
+// L computes the k-th total-degree cardinal basis function evaluated at x
+func (o *LagrangeInterpNDTotalDegree) L(k int, x []float64) (lkx float64) {
+	for j, α := range o.Alphas {
+		lkx += o.Coefs[k][j] * monomial(x, α)
+	}
+	return
+}
+
+// EstimateMaxErr estimates the maximum error by sampling nsta points along each axis of
+// [-1,1]^D. It also returns the location (xloc) of the estimated max error
+func (o *LagrangeInterpNDTotalDegree) EstimateMaxErr(nsta int, f SsND) (maxerr float64, xloc []float64) {
+	xloc = make([]float64, o.D)
+	x := make([]float64, o.D)
+	var walk func(k int)
+	walk = func(k int) {
+		if k == o.D {
+			fx, err := f(x)
+			if err != nil {
+				chk.Panic("f(x) failed:%v\n", err)
+			}
+			ix, err := o.I(x, f)
+			if err != nil {
+				chk.Panic("I(x) failed:%v\n", err)
+			}
+			e := math.Abs(fx - ix)
+			if e > maxerr {
+				maxerr = e
+				copy(xloc, x)
+			}
+			return
+		}
+		for i := 0; i < nsta; i++ {
+			x[k] = -1.0 + 2.0*float64(i)/float64(nsta-1)
+			walk(k + 1)
+		}
+	}
+	walk(0)
+	return
+}
+
+// monomial evaluates x^α = Πk x[k]^α[k]
+func monomial(x []float64, α []int) (v float64) {
+	v = 1
+	for k, a := range α {
+		for p := 0; p < a; p++ {
+			v *= x[k]
+		}
+	}
+	return
+}
+
+// monoUpToEnum enumerates all d-dimensional exponent vectors α with |α|_1 ≤ n, grouped by
+// increasing total degree (graded order) and, within each degree, in lexicographic order;
+// this mirrors the set produced by mono_upto_enum in the LAGRANGE_ND library
+func monoUpToEnum(d, n int) (alphas [][]int) {
+	for deg := 0; deg <= n; deg++ {
+		alphas = append(alphas, monoBetweenEnum(d, deg)...)
+	}
+	return
+}
+
+// monoBetweenEnum enumerates all d-dimensional exponent vectors α with |α|_1 == deg, in
+// lexicographic order
+func monoBetweenEnum(d, deg int) (alphas [][]int) {
+	α := make([]int, d)
+	var rec func(k, rem int)
+	rec = func(k, rem int) {
+		if k == d-1 {
+			α[k] = rem
+			alpha := make([]int, d)
+			copy(alpha, α)
+			alphas = append(alphas, alpha)
+			return
+		}
+		for v := 0; v <= rem; v++ {
+			α[k] = v
+			rec(k+1, rem-v)
+		}
+	}
+	rec(0, deg)
+	return
+}
+
+// matInv inverts a square matrix A via Gauss-Jordan elimination with partial pivoting
+func matInv(A [][]float64) (Ainv [][]float64, err error) {
+	m := len(A)
+	aug := make([][]float64, m)
+	for i := 0; i < m; i++ {
+		aug[i] = make([]float64, 2*m)
+		copy(aug[i], A[i])
+		aug[i][m+i] = 1
+	}
+	for col := 0; col < m; col++ {
+		piv := col
+		for row := col + 1; row < m; row++ {
+			if math.Abs(aug[row][col]) > math.Abs(aug[piv][col]) {
+				piv = row
+			}
+		}
+		if math.Abs(aug[piv][col]) < 1e-14 {
+			return nil, chk.Err("matrix is singular or nearly singular at column %d\n", col)
+		}
+		aug[col], aug[piv] = aug[piv], aug[col]
+		pivot := aug[col][col]
+		for j := 0; j < 2*m; j++ {
+			aug[col][j] /= pivot
+		}
+		for row := 0; row < m; row++ {
+			if row == col {
+				continue
+			}
+			factor := aug[row][col]
+			for j := 0; j < 2*m; j++ {
+				aug[row][j] -= factor * aug[col][j]
+			}
+		}
+	}
+	Ainv = make([][]float64, m)
+	for i := 0; i < m; i++ {
+		Ainv[i] = make([]float64, m)
+		copy(Ainv[i], aug[i][m:])
+	}
+	return
+}
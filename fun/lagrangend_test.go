@@ -0,0 +1,73 @@
+// Copyright 2016 The Gosl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fun
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLagrangeInterpNDTensorAtNodes(t *testing.T) {
+	axX, err := NewLagrangeInterp(4, ChebyshevGaussLobattoKind)
+	if err != nil {
+		t.Fatal(err)
+	}
+	axY, err := NewLagrangeInterp(5, ChebyshevGaussLobattoKind)
+	if err != nil {
+		t.Fatal(err)
+	}
+	o, err := NewLagrangeInterpNDTensor([]*LagrangeInterp{axX, axY})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := func(x []float64) (float64, error) { return math.Sin(x[0]) * math.Cos(x[1]), nil }
+	for i := 0; i < axX.N+1; i++ {
+		for j := 0; j < axY.N+1; j++ {
+			node := []float64{axX.X[i], axY.X[j]}
+			ix, err := o.I(node, f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			fx, _ := f(node)
+			if math.Abs(ix-fx) > 1e-8 {
+				t.Errorf("node (%d,%d): I=%v != f=%v", i, j, ix, fx)
+			}
+		}
+	}
+}
+
+func TestLagrangeInterpNDTotalDegreeAtNodes(t *testing.T) {
+	d, N := 2, 2
+	nodes := [][]float64{
+		{0, 0}, {1, 0}, {0, 1}, {-1, 0}, {0, -1}, {1, 1},
+	}
+	o, err := NewLagrangeInterpNDTotalDegree(d, N, nodes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := func(x []float64) (float64, error) {
+		return 1 + 2*x[0] + 3*x[1] + 0.5*x[0]*x[0] + x[0]*x[1], nil
+	}
+	for k, node := range nodes {
+		// cardinal basis: L(k,·) must be 1 at its own node and 0 at every other node
+		for j := range nodes {
+			want := 0.0
+			if j == k {
+				want = 1.0
+			}
+			if math.Abs(o.L(k, nodes[j])-want) > 1e-8 {
+				t.Errorf("cardinal basis L(%d) at node %d: got %v want %v", k, j, o.L(k, nodes[j]), want)
+			}
+		}
+		ix, err := o.I(node, f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fx, _ := f(node)
+		if math.Abs(ix-fx) > 1e-8 {
+			t.Errorf("node %d: I=%v != f=%v", k, ix, fx)
+		}
+	}
+}